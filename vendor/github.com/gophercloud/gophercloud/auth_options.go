@@ -0,0 +1,175 @@
+package gophercloud
+
+import "fmt"
+
+/*
+AuthOptions stores information needed to authenticate to an OpenStack
+Cloud. You can populate one directly, or use a provider's AuthOptionsFromEnv()
+function to read relevant information from the standard environment
+variables. Pass one to a provider's AuthenticatedClient function to
+authenticate and obtain a ProviderClient representing an active session on
+that provider.
+
+Its fields are the union of those recognized by each identity implementation
+and provider, so some fields might be unused depending on the situation
+you're trying to create.
+*/
+type AuthOptions struct {
+	// IdentityEndpoint specifies the HTTP endpoint that is required to work with
+	// the Identity API of the appropriate version. While it's ultimately needed by
+	// all of the identity services, it will often be populated by a provider-level
+	// function.
+	IdentityEndpoint string `json:"-"`
+
+	// UserID and Username are valid options for identifying a user. UserID is
+	// generally preferred; Username is used as a fallback. Both can be provided
+	// at the same time, although it is recommended to use one or the other.
+	UserID   string `json:"-"`
+	Username string `json:"-"`
+
+	// Password is the basic auth password to authenticate with, used with
+	// UserID or Username.
+	Password string `json:"-"`
+
+	// DomainID and DomainName are the Keystone v3 domain the user belongs to.
+	DomainID   string `json:"-"`
+	DomainName string `json:"-"`
+
+	// TenantID and TenantName identify the project to scope the authentication
+	// to. Some providers refer to this field as "project" instead of "tenant".
+	TenantID   string `json:"-"`
+	TenantName string `json:"-"`
+
+	// AllowReauth should be set to true if you grant permission for Gophercloud
+	// to cache your credentials in memory, and to allow Gophercloud to attempt
+	// to reauthenticate automatically if/when your token expires.
+	AllowReauth bool `json:"-"`
+
+	// TokenID allows users to authenticate with an already-acquired token.
+	TokenID string `json:"-"`
+
+	// ApplicationCredentialID is the ID of an application credential to
+	// authenticate with. Application credentials are scoped to a single
+	// project and are revocable independently of the user's password.
+	ApplicationCredentialID string `json:"-"`
+
+	// ApplicationCredentialName is the name of an application credential to
+	// authenticate with. Only used if ApplicationCredentialID is not
+	// provided, in combination with UserID or Username and DomainID or
+	// DomainName.
+	ApplicationCredentialName string `json:"-"`
+
+	// ApplicationCredentialSecret is the secret for the application
+	// credential referenced by ApplicationCredentialID or
+	// ApplicationCredentialName.
+	ApplicationCredentialSecret string `json:"-"`
+}
+
+// ToTokenV3CreateMap builds the "auth" body of a v3 token creation request.
+// When ApplicationCredentialSecret is set, an "application_credential"
+// method is used instead of "password", and setting Password as well is an
+// error. With ApplicationCredentialID the user and domain fields are
+// ignored; with ApplicationCredentialName, UserID or Username (plus DomainID
+// or DomainName) identify the credential's owner.
+func (opts AuthOptions) ToTokenV3CreateMap() (map[string]interface{}, error) {
+	if opts.ApplicationCredentialSecret != "" && opts.Password != "" {
+		return nil, fmt.Errorf("only one of Password or ApplicationCredentialSecret may be provided")
+	}
+
+	if opts.ApplicationCredentialSecret != "" {
+		return opts.toApplicationCredentialCreateMap()
+	}
+
+	if opts.TokenID != "" {
+		return opts.toTokenCreateMap()
+	}
+
+	return opts.toPasswordCreateMap()
+}
+
+func (opts AuthOptions) toTokenCreateMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"token"},
+				"token":   map[string]interface{}{"id": opts.TokenID},
+			},
+		},
+	}, nil
+}
+
+func (opts AuthOptions) toApplicationCredentialCreateMap() (map[string]interface{}, error) {
+	if opts.ApplicationCredentialID == "" && opts.ApplicationCredentialName == "" {
+		return nil, fmt.Errorf("one of ApplicationCredentialID or ApplicationCredentialName is required")
+	}
+
+	applicationCredential := map[string]interface{}{
+		"secret": opts.ApplicationCredentialSecret,
+	}
+
+	if opts.ApplicationCredentialID != "" {
+		applicationCredential["id"] = opts.ApplicationCredentialID
+	} else {
+		applicationCredential["name"] = opts.ApplicationCredentialName
+
+		user := make(map[string]interface{})
+		switch {
+		case opts.UserID != "":
+			user["id"] = opts.UserID
+		case opts.Username != "":
+			user["name"] = opts.Username
+			switch {
+			case opts.DomainID != "":
+				user["domain"] = map[string]interface{}{"id": opts.DomainID}
+			case opts.DomainName != "":
+				user["domain"] = map[string]interface{}{"name": opts.DomainName}
+			default:
+				return nil, fmt.Errorf("a DomainID or DomainName is required if ApplicationCredentialName is used instead of ApplicationCredentialID, and Username (instead of UserID) is used")
+			}
+		default:
+			return nil, fmt.Errorf("a UserID or Username is required if ApplicationCredentialName is used instead of ApplicationCredentialID")
+		}
+		applicationCredential["user"] = user
+	}
+
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods":                []string{"application_credential"},
+				"application_credential": applicationCredential,
+			},
+		},
+	}, nil
+}
+
+func (opts AuthOptions) toPasswordCreateMap() (map[string]interface{}, error) {
+	if opts.Password == "" {
+		return nil, fmt.Errorf("a Password is required")
+	}
+
+	user := make(map[string]interface{})
+	switch {
+	case opts.UserID != "":
+		user["id"] = opts.UserID
+	case opts.Username != "":
+		user["name"] = opts.Username
+		switch {
+		case opts.DomainID != "":
+			user["domain"] = map[string]interface{}{"id": opts.DomainID}
+		case opts.DomainName != "":
+			user["domain"] = map[string]interface{}{"name": opts.DomainName}
+		}
+	default:
+		return nil, fmt.Errorf("a UserID or Username is required")
+	}
+	user["password"] = opts.Password
+
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods":  []string{"password"},
+				"password": map[string]interface{}{"user": user},
+			},
+		},
+	}, nil
+}
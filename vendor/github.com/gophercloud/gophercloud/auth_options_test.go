@@ -0,0 +1,107 @@
+package gophercloud
+
+import "testing"
+
+func TestToTokenV3CreateMap_Password(t *testing.T) {
+	opts := AuthOptions{Username: "admin", Password: "secret", DomainID: "default"}
+	m, err := opts.ToTokenV3CreateMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	identity := m["auth"].(map[string]interface{})["identity"].(map[string]interface{})
+	methods := identity["methods"].([]string)
+	if len(methods) != 1 || methods[0] != "password" {
+		t.Errorf("expected methods [password], got %v", methods)
+	}
+}
+
+func TestToTokenV3CreateMap_Token(t *testing.T) {
+	opts := AuthOptions{TokenID: "abc123"}
+	m, err := opts.ToTokenV3CreateMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	identity := m["auth"].(map[string]interface{})["identity"].(map[string]interface{})
+	methods := identity["methods"].([]string)
+	if len(methods) != 1 || methods[0] != "token" {
+		t.Errorf("expected methods [token], got %v", methods)
+	}
+	token := identity["token"].(map[string]interface{})
+	if token["id"] != "abc123" {
+		t.Errorf("expected token id abc123, got %v", token["id"])
+	}
+}
+
+func TestToTokenV3CreateMap_ApplicationCredentialByID(t *testing.T) {
+	opts := AuthOptions{
+		ApplicationCredentialID:     "ac-id",
+		ApplicationCredentialSecret: "ac-secret",
+	}
+	m, err := opts.ToTokenV3CreateMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	identity := m["auth"].(map[string]interface{})["identity"].(map[string]interface{})
+	methods := identity["methods"].([]string)
+	if len(methods) != 1 || methods[0] != "application_credential" {
+		t.Errorf("expected methods [application_credential], got %v", methods)
+	}
+	ac := identity["application_credential"].(map[string]interface{})
+	if ac["id"] != "ac-id" || ac["secret"] != "ac-secret" {
+		t.Errorf("unexpected application_credential block: %v", ac)
+	}
+	if _, ok := ac["user"]; ok {
+		t.Errorf("user should not be set when ApplicationCredentialID is used, got %v", ac["user"])
+	}
+}
+
+func TestToTokenV3CreateMap_ApplicationCredentialByName(t *testing.T) {
+	opts := AuthOptions{
+		ApplicationCredentialName:   "ac-name",
+		ApplicationCredentialSecret: "ac-secret",
+		Username:                    "admin",
+		DomainID:                    "default",
+	}
+	m, err := opts.ToTokenV3CreateMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	identity := m["auth"].(map[string]interface{})["identity"].(map[string]interface{})
+	ac := identity["application_credential"].(map[string]interface{})
+	if ac["name"] != "ac-name" {
+		t.Errorf("expected application_credential name ac-name, got %v", ac["name"])
+	}
+	user := ac["user"].(map[string]interface{})
+	if user["name"] != "admin" {
+		t.Errorf("expected user name admin, got %v", user["name"])
+	}
+}
+
+func TestToTokenV3CreateMap_ApplicationCredentialByNameRequiresDomain(t *testing.T) {
+	opts := AuthOptions{
+		ApplicationCredentialName:   "ac-name",
+		ApplicationCredentialSecret: "ac-secret",
+		Username:                    "admin",
+	}
+	if _, err := opts.ToTokenV3CreateMap(); err == nil {
+		t.Fatal("expected an error when DomainID/DomainName is missing")
+	}
+}
+
+func TestToTokenV3CreateMap_RejectsPasswordAndApplicationCredential(t *testing.T) {
+	opts := AuthOptions{
+		Password:                    "secret",
+		ApplicationCredentialID:     "ac-id",
+		ApplicationCredentialSecret: "ac-secret",
+	}
+	if _, err := opts.ToTokenV3CreateMap(); err == nil {
+		t.Fatal("expected an error when both Password and ApplicationCredentialSecret are set")
+	}
+}
+
+func TestToTokenV3CreateMap_RequiresPassword(t *testing.T) {
+	opts := AuthOptions{Username: "admin"}
+	if _, err := opts.ToTokenV3CreateMap(); err == nil {
+		t.Fatal("expected an error when no Password, TokenID, or application credential is set")
+	}
+}
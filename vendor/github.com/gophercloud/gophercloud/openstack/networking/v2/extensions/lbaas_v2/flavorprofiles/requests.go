@@ -0,0 +1,96 @@
+package flavorprofiles
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorProfileListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections
+// through the API. Filtering is achieved by passing in struct field values
+// that map to the flavor profile attributes you want to see returned.
+type ListOpts struct {
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+	ID           string `q:"id"`
+	Limit        int    `q:"limit"`
+	Marker       string `q:"marker"`
+	SortKey      string `q:"sort_key"`
+	SortDir      string `q:"sort_dir"`
+}
+
+// ToFlavorProfileListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorProfileListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavor profiles. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorProfileListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorProfilePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the main Create operation in this package.
+type CreateOptsBuilder interface {
+	ToFlavorProfileCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Required. Human-readable name for the flavor profile.
+	Name string `json:"name" required:"true"`
+	// Required. The name of the provider this profile applies to, e.g.
+	// "amphora" or "octavia".
+	ProviderName string `json:"provider_name" required:"true"`
+	// Required. Provider-specific tuning data, encoded as a raw JSON string.
+	FlavorData string `json:"flavor_data" required:"true"`
+}
+
+// ToFlavorProfileCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFlavorProfileCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavorprofile")
+}
+
+// Create is an operation which provisions a new flavor profile based on the
+// configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// Get retrieves a particular flavor profile based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// Delete will permanently delete a particular flavor profile based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
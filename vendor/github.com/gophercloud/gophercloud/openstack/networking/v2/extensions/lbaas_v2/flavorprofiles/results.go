@@ -0,0 +1,88 @@
+package flavorprofiles
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// FlavorProfile is the OpenStack Octavia flavor profile representation. A
+// flavor profile carries the provider-specific tuning data that one or more
+// Flavors reference.
+type FlavorProfile struct {
+	// ID is the unique ID of the flavor profile.
+	ID string `json:"id"`
+	// Name is the human-readable name of the flavor profile.
+	Name string `json:"name"`
+	// ProviderName is the name of the provider this profile applies to.
+	ProviderName string `json:"provider_name"`
+	// FlavorData is the provider-specific tuning data, encoded as a raw
+	// JSON string.
+	FlavorData string `json:"flavor_data"`
+}
+
+// FlavorProfilePage is the page returned by a pager when traversing over a
+// collection of flavor profiles.
+type FlavorProfilePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flavor profiles has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r FlavorProfilePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flavorprofiles_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlavorProfilePage struct is empty.
+func (r FlavorProfilePage) IsEmpty() (bool, error) {
+	is, err := ExtractFlavorProfiles(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavorProfiles accepts a Page struct, specifically a
+// FlavorProfilePage struct, and extracts the elements into a slice of
+// FlavorProfile structs.
+func ExtractFlavorProfiles(r pagination.Page) ([]FlavorProfile, error) {
+	var s struct {
+		FlavorProfiles []FlavorProfile `json:"flavorprofiles"`
+	}
+	err := (r.(FlavorProfilePage)).ExtractInto(&s)
+	return s.FlavorProfiles, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a FlavorProfile.
+func (r commonResult) Extract() (*FlavorProfile, error) {
+	var s struct {
+		FlavorProfile *FlavorProfile `json:"flavorprofile"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FlavorProfile, err
+}
+
+// CreateResult represents the result of a Create operation. Call its
+// Extract method to interpret it as a FlavorProfile.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a FlavorProfile.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
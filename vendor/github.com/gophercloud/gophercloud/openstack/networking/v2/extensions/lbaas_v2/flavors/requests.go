@@ -0,0 +1,158 @@
+package flavors
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections
+// through the API. Filtering is achieved by passing in struct field values
+// that map to the flavor attributes you want to see returned.
+type ListOpts struct {
+	Name            string `q:"name"`
+	FlavorProfileID string `q:"flavor_profile_id"`
+	Enabled         *bool  `q:"enabled"`
+	ID              string `q:"id"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+}
+
+// ToFlavorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavors. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the main Create operation in this package.
+type CreateOptsBuilder interface {
+	ToFlavorCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Required. Human-readable name for the flavor.
+	Name string `json:"name" required:"true"`
+	// Optional. Human-readable description for the flavor.
+	Description string `json:"description,omitempty"`
+	// Required. The ID of the flavor profile this flavor uses.
+	FlavorProfileID string `json:"flavor_profile_id" required:"true"`
+	// Optional. Whether the flavor is enabled for use. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToFlavorCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFlavorCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Create is an operation which provisions a new flavor based on the
+// configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// Get retrieves a particular flavor based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the main Update operation in this package.
+type UpdateOptsBuilder interface {
+	ToFlavorUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation.
+type UpdateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// ToFlavorUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToFlavorUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Update is an operation which modifies the attributes of the specified
+// flavor.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// Delete will permanently delete a particular flavor based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
+
+// ResolveFlavorByName looks up a flavor by its human-readable name and
+// returns its ID. It is a convenience helper for callers, such as
+// loadbalancers.CreateOpts.FlavorName, that want to specify a flavor
+// symbolically instead of by UUID.
+func ResolveFlavorByName(c *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(c, ListOpts{Name: name}).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractFlavors(pages)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(all) {
+	case 0:
+		return "", fmt.Errorf("no flavor found named %q", name)
+	case 1:
+		return all[0].ID, nil
+	default:
+		return "", fmt.Errorf("more than one flavor found named %q", name)
+	}
+}
@@ -0,0 +1,94 @@
+package flavors
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Flavor is the OpenStack Octavia flavor representation. A flavor pairs a
+// human-readable name with a FlavorProfile that carries the actual
+// provider-specific tuning data.
+type Flavor struct {
+	// ID is the unique ID of the flavor.
+	ID string `json:"id"`
+	// Name is the human-readable name of the flavor.
+	Name string `json:"name"`
+	// Description is the human-readable description of the flavor.
+	Description string `json:"description"`
+	// FlavorProfileID is the ID of the flavor profile this flavor uses.
+	FlavorProfileID string `json:"flavor_profile_id"`
+	// Enabled is whether the flavor is enabled for use.
+	Enabled bool `json:"enabled"`
+}
+
+// FlavorPage is the page returned by a pager when traversing over a
+// collection of flavors.
+type FlavorPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flavors has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r FlavorPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flavors_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlavorPage struct is empty.
+func (r FlavorPage) IsEmpty() (bool, error) {
+	is, err := ExtractFlavors(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavors accepts a Page struct, specifically a FlavorPage struct,
+// and extracts the elements into a slice of Flavor structs.
+func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
+	var s struct {
+		Flavors []Flavor `json:"flavors"`
+	}
+	err := (r.(FlavorPage)).ExtractInto(&s)
+	return s.Flavors, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Flavor.
+func (r commonResult) Extract() (*Flavor, error) {
+	var s struct {
+		Flavor *Flavor `json:"flavor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavor, err
+}
+
+// CreateResult represents the result of a Create operation. Call its
+// Extract method to interpret it as a Flavor.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Flavor.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as a Flavor.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,363 @@
+package l7policies
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action is a type for specifying the action to take when an L7 policy
+// matches a request.
+type Action string
+
+const (
+	ActionRedirectToPool Action = "REDIRECT_TO_POOL"
+	ActionRedirectToURL  Action = "REDIRECT_TO_URL"
+	ActionReject         Action = "REJECT"
+)
+
+// RuleType is a type for specifying what part of a request an L7 rule
+// inspects.
+type RuleType string
+
+const (
+	TypeCookie   RuleType = "COOKIE"
+	TypeFileType RuleType = "FILE_TYPE"
+	TypeHeader   RuleType = "HEADER"
+	TypeHostName RuleType = "HOST_NAME"
+	TypePath     RuleType = "PATH"
+)
+
+// CompareType is a type for specifying how an L7 rule's Value is compared
+// against the inspected request.
+type CompareType string
+
+const (
+	CompareTypeContains   CompareType = "CONTAINS"
+	CompareTypeEndsWith   CompareType = "ENDS_WITH"
+	CompareTypeEqualTo    CompareType = "EQUAL_TO"
+	CompareTypeRegex      CompareType = "REGEX"
+	CompareTypeStartsWith CompareType = "STARTS_WITH"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToL7PolicyListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map
+// to the L7 policy attributes you want to see returned.
+type ListOpts struct {
+	Action         string `q:"action"`
+	Description    string `q:"description"`
+	ListenerID     string `q:"listener_id"`
+	Name           string `q:"name"`
+	TenantID       string `q:"tenant_id"`
+	ProjectID      string `q:"project_id"`
+	Position       int    `q:"position"`
+	RedirectPoolID string `q:"redirect_pool_id"`
+	RedirectURL    string `q:"redirect_url"`
+	AdminStateUp   *bool  `q:"admin_state_up"`
+	ID             string `q:"id"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+}
+
+// ToL7PolicyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToL7PolicyListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of L7
+// policies. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToL7PolicyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return L7PolicyPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the main Create operation in this package.
+type CreateOptsBuilder interface {
+	ToL7PolicyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Required. The ID of the listener the L7 policy is attached to.
+	ListenerID string `json:"listener_id" required:"true"`
+	// Required. The action to take when this policy's conditions are met.
+	Action Action `json:"action" required:"true"`
+	// Optional. Human-readable name for the L7 policy.
+	Name string `json:"name,omitempty"`
+	// Optional. Human-readable description for the L7 policy.
+	Description string `json:"description,omitempty"`
+	// Optional. The position of this policy on the listener. Positions start
+	// at 1.
+	Position int32 `json:"position,omitempty"`
+	// Required when Action is REDIRECT_TO_POOL. The ID of the pool to
+	// redirect requests to.
+	RedirectPoolID string `json:"redirect_pool_id,omitempty"`
+	// Required when Action is REDIRECT_TO_URL. The URL to redirect requests
+	// to.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// Optional. The administrative state of the L7 policy. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+	// Optional. The UUID of the tenant who owns the L7 policy.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Optional. The UUID of the project who owns the L7 policy.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToL7PolicyCreateMap casts a CreateOpts struct to a map, validating that
+// the Action and its required companion fields are consistent.
+func (opts CreateOpts) ToL7PolicyCreateMap() (map[string]interface{}, error) {
+	switch opts.Action {
+	case ActionRedirectToPool:
+		if opts.RedirectPoolID == "" {
+			return nil, fmt.Errorf("RedirectPoolID is required when Action is %s", ActionRedirectToPool)
+		}
+	case ActionRedirectToURL:
+		if opts.RedirectURL == "" {
+			return nil, fmt.Errorf("RedirectURL is required when Action is %s", ActionRedirectToURL)
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "l7policy")
+}
+
+// Create is an operation which provisions a new L7 policy on the specified
+// listener based on the configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToL7PolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// Get retrieves a particular L7 policy based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the main Update operation in this package.
+type UpdateOptsBuilder interface {
+	ToL7PolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation.
+type UpdateOpts struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Action         Action  `json:"action,omitempty"`
+	Position       int32   `json:"position,omitempty"`
+	RedirectPoolID string  `json:"redirect_pool_id,omitempty"`
+	RedirectURL    string  `json:"redirect_url,omitempty"`
+	AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+}
+
+// ToL7PolicyUpdateMap casts an UpdateOpts struct to a map, validating that
+// the Action and its required companion fields are consistent.
+func (opts UpdateOpts) ToL7PolicyUpdateMap() (map[string]interface{}, error) {
+	switch opts.Action {
+	case ActionRedirectToPool:
+		if opts.RedirectPoolID == "" {
+			return nil, fmt.Errorf("RedirectPoolID is required when Action is %s", ActionRedirectToPool)
+		}
+	case ActionRedirectToURL:
+		if opts.RedirectURL == "" {
+			return nil, fmt.Errorf("RedirectURL is required when Action is %s", ActionRedirectToURL)
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "l7policy")
+}
+
+// Update is an operation which modifies the attributes of the specified L7
+// policy.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToL7PolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// Delete will permanently delete a particular L7 policy based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
+
+// ListRulesOptsBuilder allows extensions to add additional parameters to
+// the ListRules request.
+type ListRulesOptsBuilder interface {
+	ToL7RuleListQuery() (string, error)
+}
+
+// ListRulesOpts allows the filtering and sorting of paginated collections
+// of rules belonging to an L7 policy.
+type ListRulesOpts struct {
+	RuleType     string `q:"type"`
+	TenantID     string `q:"tenant_id"`
+	ProjectID    string `q:"project_id"`
+	CompareType  string `q:"compare_type"`
+	Value        string `q:"value"`
+	Key          string `q:"key"`
+	Invert       *bool  `q:"invert"`
+	AdminStateUp *bool  `q:"admin_state_up"`
+	ID           string `q:"id"`
+	Limit        int    `q:"limit"`
+	Marker       string `q:"marker"`
+	SortKey      string `q:"sort_key"`
+	SortDir      string `q:"sort_dir"`
+}
+
+// ToL7RuleListQuery formats a ListRulesOpts into a query string.
+func (opts ListRulesOpts) ToL7RuleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListRules returns a Pager which allows you to iterate over the
+// collection of rules belonging to the given L7 policy.
+func ListRules(c *gophercloud.ServiceClient, policyID string, opts ListRulesOptsBuilder) pagination.Pager {
+	url := ruleRootURL(c, policyID)
+	if opts != nil {
+		query, err := opts.ToL7RuleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return L7RulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateRuleOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the CreateRule operation in this package.
+type CreateRuleOptsBuilder interface {
+	ToL7RuleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateRuleOpts is the common options struct used in this package's
+// CreateRule operation.
+type CreateRuleOpts struct {
+	// Required. The part of the request this rule inspects.
+	RuleType RuleType `json:"type" required:"true"`
+	// Required. How Value is compared against the inspected request.
+	CompareType CompareType `json:"compare_type" required:"true"`
+	// Required. The value to match against.
+	Value string `json:"value" required:"true"`
+	// Optional. The key to inspect, required when RuleType is COOKIE or
+	// HEADER.
+	Key string `json:"key,omitempty"`
+	// Optional. Inverts the result of the comparison.
+	Invert bool `json:"invert,omitempty"`
+	// Optional. The administrative state of the rule.
+	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+	// Optional. The UUID of the tenant who owns the rule.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Optional. The UUID of the project who owns the rule.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToL7RuleCreateMap casts a CreateRuleOpts struct to a map.
+func (opts CreateRuleOpts) ToL7RuleCreateMap() (map[string]interface{}, error) {
+	switch opts.RuleType {
+	case TypeCookie, TypeHeader:
+		if opts.Key == "" {
+			return nil, fmt.Errorf("Key is required when RuleType is %s", opts.RuleType)
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "rule")
+}
+
+// CreateRule is an operation which provisions a new rule on the specified
+// L7 policy.
+func CreateRule(c *gophercloud.ServiceClient, policyID string, opts CreateRuleOptsBuilder) (r CreateRuleResult) {
+	b, err := opts.ToL7RuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(ruleRootURL(c, policyID), b, &r.Body, nil)
+	return
+}
+
+// GetRule retrieves a particular L7 rule based on its unique ID and the
+// policy it belongs to.
+func GetRule(c *gophercloud.ServiceClient, policyID string, ruleID string) (r GetRuleResult) {
+	_, r.Err = c.Get(ruleResourceURL(c, policyID, ruleID), &r.Body, nil)
+	return
+}
+
+// UpdateRuleOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the UpdateRule operation in this package.
+type UpdateRuleOptsBuilder interface {
+	ToL7RuleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateRuleOpts is the common options struct used in this package's
+// UpdateRule operation.
+type UpdateRuleOpts struct {
+	RuleType     RuleType    `json:"type,omitempty"`
+	CompareType  CompareType `json:"compare_type,omitempty"`
+	Value        string      `json:"value,omitempty"`
+	Key          string      `json:"key,omitempty"`
+	Invert       *bool       `json:"invert,omitempty"`
+	AdminStateUp *bool       `json:"admin_state_up,omitempty"`
+}
+
+// ToL7RuleUpdateMap casts an UpdateRuleOpts struct to a map.
+func (opts UpdateRuleOpts) ToL7RuleUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "rule")
+}
+
+// UpdateRule is an operation which modifies the attributes of the
+// specified L7 rule.
+func UpdateRule(c *gophercloud.ServiceClient, policyID string, ruleID string, opts UpdateRuleOptsBuilder) (r UpdateRuleResult) {
+	b, err := opts.ToL7RuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(ruleResourceURL(c, policyID, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// DeleteRule will permanently delete a particular L7 rule based on its
+// unique ID and the policy it belongs to.
+func DeleteRule(c *gophercloud.ServiceClient, policyID string, ruleID string) (r DeleteRuleResult) {
+	_, r.Err = c.Delete(ruleResourceURL(c, policyID, ruleID), nil)
+	return
+}
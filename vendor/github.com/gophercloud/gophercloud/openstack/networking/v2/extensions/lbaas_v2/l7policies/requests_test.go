@@ -0,0 +1,72 @@
+package l7policies
+
+import "testing"
+
+func TestToL7PolicyCreateMap_RedirectToPoolRequiresPoolID(t *testing.T) {
+	opts := CreateOpts{
+		ListenerID: "listener-1",
+		Action:     ActionRedirectToPool,
+	}
+	if _, err := opts.ToL7PolicyCreateMap(); err == nil {
+		t.Fatal("expected an error when RedirectPoolID is missing for ActionRedirectToPool")
+	}
+
+	opts.RedirectPoolID = "pool-1"
+	if _, err := opts.ToL7PolicyCreateMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestToL7PolicyCreateMap_RedirectToURLRequiresURL(t *testing.T) {
+	opts := CreateOpts{
+		ListenerID: "listener-1",
+		Action:     ActionRedirectToURL,
+	}
+	if _, err := opts.ToL7PolicyCreateMap(); err == nil {
+		t.Fatal("expected an error when RedirectURL is missing for ActionRedirectToURL")
+	}
+
+	opts.RedirectURL = "http://example.com"
+	if _, err := opts.ToL7PolicyCreateMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestToL7PolicyCreateMap_RejectNeedsNoExtraFields(t *testing.T) {
+	opts := CreateOpts{
+		ListenerID: "listener-1",
+		Action:     ActionReject,
+	}
+	if _, err := opts.ToL7PolicyCreateMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestToL7RuleCreateMap_CookieAndHeaderRequireKey(t *testing.T) {
+	for _, ruleType := range []RuleType{TypeCookie, TypeHeader} {
+		opts := CreateRuleOpts{
+			RuleType:    ruleType,
+			CompareType: CompareTypeEqualTo,
+			Value:       "x",
+		}
+		if _, err := opts.ToL7RuleCreateMap(); err == nil {
+			t.Fatalf("expected an error when Key is missing for RuleType %s", ruleType)
+		}
+
+		opts.Key = "some-key"
+		if _, err := opts.ToL7RuleCreateMap(); err != nil {
+			t.Fatalf("unexpected error for RuleType %s: %v", ruleType, err)
+		}
+	}
+}
+
+func TestToL7RuleCreateMap_PathDoesNotRequireKey(t *testing.T) {
+	opts := CreateRuleOpts{
+		RuleType:    TypePath,
+		CompareType: CompareTypeStartsWith,
+		Value:       "/foo",
+	}
+	if _, err := opts.ToL7RuleCreateMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
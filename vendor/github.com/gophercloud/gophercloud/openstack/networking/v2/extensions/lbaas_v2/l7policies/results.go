@@ -0,0 +1,206 @@
+package l7policies
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// L7Policy is the OpenStack LBaaS v2 L7 policy representation.
+type L7Policy struct {
+	// ID is the unique ID of the L7 policy.
+	ID string `json:"id"`
+	// Name is the human-readable name of the L7 policy.
+	Name string `json:"name"`
+	// Description is the human-readable description of the L7 policy.
+	Description string `json:"description"`
+	// ListenerID is the ID of the listener this policy is attached to.
+	ListenerID string `json:"listener_id"`
+	// Action is the action taken when this policy's rules match a request.
+	Action string `json:"action"`
+	// Position is the position of this policy on the listener.
+	Position int32 `json:"position"`
+	// RedirectPoolID is the ID of the pool to redirect to, set when Action
+	// is REDIRECT_TO_POOL.
+	RedirectPoolID string `json:"redirect_pool_id"`
+	// RedirectURL is the URL to redirect to, set when Action is
+	// REDIRECT_TO_URL.
+	RedirectURL string `json:"redirect_url"`
+	// AdminStateUp is the administrative state of the policy.
+	AdminStateUp bool `json:"admin_state_up"`
+	// ProvisioningStatus is the provisioning status of the policy.
+	ProvisioningStatus string `json:"provisioning_status"`
+	// TenantID is the UUID of the tenant who owns the policy.
+	TenantID string `json:"tenant_id"`
+	// ProjectID is the UUID of the project who owns the policy.
+	ProjectID string `json:"project_id"`
+	// Rules are the L7 rules belonging to this policy.
+	Rules []L7Rule `json:"rules"`
+}
+
+// L7Rule is the OpenStack LBaaS v2 L7 rule representation.
+type L7Rule struct {
+	// ID is the unique ID of the L7 rule.
+	ID string `json:"id"`
+	// RuleType is the part of the request this rule inspects.
+	RuleType string `json:"type"`
+	// CompareType is how Value is compared against the inspected request.
+	CompareType string `json:"compare_type"`
+	// Value is the value to match against.
+	Value string `json:"value"`
+	// Key is the key to inspect, set when RuleType is COOKIE or HEADER.
+	Key string `json:"key"`
+	// Invert is whether the result of the comparison is inverted.
+	Invert bool `json:"invert"`
+	// AdminStateUp is the administrative state of the rule.
+	AdminStateUp bool `json:"admin_state_up"`
+	// ProvisioningStatus is the provisioning status of the rule.
+	ProvisioningStatus string `json:"provisioning_status"`
+	// TenantID is the UUID of the tenant who owns the rule.
+	TenantID string `json:"tenant_id"`
+	// ProjectID is the UUID of the project who owns the rule.
+	ProjectID string `json:"project_id"`
+}
+
+// L7PolicyPage is the page returned by a pager when traversing over a
+// collection of L7 policies.
+type L7PolicyPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of L7 policies has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r L7PolicyPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"l7policies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an L7PolicyPage struct is empty.
+func (r L7PolicyPage) IsEmpty() (bool, error) {
+	is, err := ExtractL7Policies(r)
+	return len(is) == 0, err
+}
+
+// ExtractL7Policies accepts a Page struct, specifically an L7PolicyPage
+// struct, and extracts the elements into a slice of L7Policy structs.
+func ExtractL7Policies(r pagination.Page) ([]L7Policy, error) {
+	var s struct {
+		L7Policies []L7Policy `json:"l7policies"`
+	}
+	err := (r.(L7PolicyPage)).ExtractInto(&s)
+	return s.L7Policies, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an L7 policy.
+func (r commonResult) Extract() (*L7Policy, error) {
+	var s struct {
+		L7Policy *L7Policy `json:"l7policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.L7Policy, err
+}
+
+// CreateResult represents the result of a Create operation. Call its
+// Extract method to interpret it as an L7Policy.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as an L7Policy.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as an L7Policy.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// L7RulePage is the page returned by a pager when traversing over a
+// collection of L7 rules.
+type L7RulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of L7 rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r L7RulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an L7RulePage struct is empty.
+func (r L7RulePage) IsEmpty() (bool, error) {
+	is, err := ExtractRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractRules accepts a Page struct, specifically an L7RulePage struct,
+// and extracts the elements into a slice of L7Rule structs.
+func ExtractRules(r pagination.Page) ([]L7Rule, error) {
+	var s struct {
+		Rules []L7Rule `json:"rules"`
+	}
+	err := (r.(L7RulePage)).ExtractInto(&s)
+	return s.Rules, err
+}
+
+type commonRuleResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an L7Rule.
+func (r commonRuleResult) Extract() (*L7Rule, error) {
+	var s struct {
+		Rule *L7Rule `json:"rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+// CreateRuleResult represents the result of a CreateRule operation. Call
+// its Extract method to interpret it as an L7Rule.
+type CreateRuleResult struct {
+	commonRuleResult
+}
+
+// GetRuleResult represents the result of a GetRule operation. Call its
+// Extract method to interpret it as an L7Rule.
+type GetRuleResult struct {
+	commonRuleResult
+}
+
+// UpdateRuleResult represents the result of an UpdateRule operation. Call
+// its Extract method to interpret it as an L7Rule.
+type UpdateRuleResult struct {
+	commonRuleResult
+}
+
+// DeleteRuleResult represents the result of a DeleteRule operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteRuleResult struct {
+	gophercloud.ErrResult
+}
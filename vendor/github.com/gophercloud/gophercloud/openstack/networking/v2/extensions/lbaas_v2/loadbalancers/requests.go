@@ -1,7 +1,10 @@
 package loadbalancers
 
 import (
+	"fmt"
+
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/flavors"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -90,6 +93,10 @@ type CreateOpts struct {
 	AdminStateUp *bool `json:"admin_state_up,omitempty"`
 	// Optional. The UUID of a flavor.
 	Flavor string `json:"flavor,omitempty"`
+	// Optional. The name of a flavor, resolved to a UUID via
+	// flavors.ResolveFlavorByName at Create time. Mutually exclusive with
+	// Flavor.
+	FlavorName string `json:"-"`
 	// Optional. The name of the provider.
 	Provider string `json:"provider,omitempty"`
 }
@@ -99,6 +106,49 @@ func (opts CreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error)
 	return gophercloud.BuildRequestBody(opts, "loadbalancer")
 }
 
+// flavorNamer is satisfied by any CreateOptsBuilder that carries a
+// FlavorName to resolve at Create time, including wrapper structs that
+// embed CreateOpts and pick up GetFlavorName through method promotion.
+type flavorNamer interface {
+	GetFlavorName() string
+}
+
+// GetFlavorName implements flavorNamer.
+func (opts CreateOpts) GetFlavorName() string {
+	return opts.FlavorName
+}
+
+// resolveFlavorByName is a seam for testing Create's FlavorName resolution
+// without requiring a live flavors API.
+var resolveFlavorByName = flavors.ResolveFlavorByName
+
+// applyFlavorName resolves a flavorNamer's FlavorName against the flavors
+// API and sets the result onto the "flavor" key of an already-built create
+// map, erroring if Flavor was also explicitly set. It is a no-op for
+// builders that don't implement flavorNamer or that leave FlavorName unset.
+func applyFlavorName(c *gophercloud.ServiceClient, opts CreateOptsBuilder, b map[string]interface{}) (map[string]interface{}, error) {
+	namer, ok := opts.(flavorNamer)
+	if !ok {
+		return b, nil
+	}
+	flavorName := namer.GetFlavorName()
+	if flavorName == "" {
+		return b, nil
+	}
+
+	lb, _ := b["loadbalancer"].(map[string]interface{})
+	if _, hasFlavor := lb["flavor"]; hasFlavor {
+		return nil, fmt.Errorf("only one of Flavor or FlavorName may be specified")
+	}
+
+	flavorID, err := resolveFlavorByName(c, flavorName)
+	if err != nil {
+		return nil, err
+	}
+	lb["flavor"] = flavorID
+	return b, nil
+}
+
 // Create is an operation which provisions a new loadbalancer based on the
 // configuration defined in the CreateOpts struct. Once the request is
 // validated and progress has started on the provisioning process, a
@@ -112,6 +162,13 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 		r.Err = err
 		return
 	}
+
+	b, err = applyFlavorName(c, opts, b)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
 	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
 	return
 }
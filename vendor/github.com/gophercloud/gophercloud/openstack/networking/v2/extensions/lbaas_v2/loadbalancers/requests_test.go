@@ -0,0 +1,101 @@
+package loadbalancers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// wrappedCreateOpts mimics the Terraform-provider pattern of embedding
+// CreateOpts in a wrapper struct to add provider-specific fields. It should
+// pick up GetFlavorName (and therefore FlavorName resolution) through
+// method promotion without any extra plumbing.
+type wrappedCreateOpts struct {
+	CreateOpts
+	ProviderSpecificField string
+}
+
+func TestApplyFlavorName_NoOpWithoutFlavorName(t *testing.T) {
+	opts := CreateOpts{Name: "lb1"}
+	b, _ := opts.ToLoadBalancerCreateMap()
+
+	out, err := applyFlavorName(nil, opts, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lb := out["loadbalancer"].(map[string]interface{})
+	if _, ok := lb["flavor"]; ok {
+		t.Errorf("expected no flavor key, got %v", lb["flavor"])
+	}
+}
+
+func TestApplyFlavorName_ConflictsWithFlavor(t *testing.T) {
+	opts := CreateOpts{Name: "lb1", Flavor: "flavor-uuid", FlavorName: "small"}
+	b, _ := opts.ToLoadBalancerCreateMap()
+
+	if _, err := applyFlavorName(nil, opts, b); err == nil {
+		t.Fatal("expected an error when both Flavor and FlavorName are set")
+	}
+}
+
+func TestApplyFlavorName_ResolveSuccess(t *testing.T) {
+	original := resolveFlavorByName
+	defer func() { resolveFlavorByName = original }()
+	resolveFlavorByName = func(c *gophercloud.ServiceClient, name string) (string, error) {
+		if name != "small" {
+			t.Fatalf("unexpected flavor name %q", name)
+		}
+		return "resolved-flavor-id", nil
+	}
+
+	opts := CreateOpts{Name: "lb1", FlavorName: "small"}
+	b, _ := opts.ToLoadBalancerCreateMap()
+
+	out, err := applyFlavorName(nil, opts, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lb := out["loadbalancer"].(map[string]interface{})
+	if lb["flavor"] != "resolved-flavor-id" {
+		t.Errorf("expected resolved flavor id, got %v", lb["flavor"])
+	}
+}
+
+func TestApplyFlavorName_ResolveFailure(t *testing.T) {
+	original := resolveFlavorByName
+	defer func() { resolveFlavorByName = original }()
+	resolveFlavorByName = func(c *gophercloud.ServiceClient, name string) (string, error) {
+		return "", fmt.Errorf("no flavor found named %q", name)
+	}
+
+	opts := CreateOpts{Name: "lb1", FlavorName: "missing"}
+	b, _ := opts.ToLoadBalancerCreateMap()
+
+	if _, err := applyFlavorName(nil, opts, b); err == nil {
+		t.Fatal("expected the resolver's error to propagate")
+	}
+}
+
+func TestApplyFlavorName_WorksThroughEmbeddedWrapper(t *testing.T) {
+	original := resolveFlavorByName
+	defer func() { resolveFlavorByName = original }()
+	resolveFlavorByName = func(c *gophercloud.ServiceClient, name string) (string, error) {
+		return "resolved-flavor-id", nil
+	}
+
+	opts := wrappedCreateOpts{
+		CreateOpts:            CreateOpts{Name: "lb1", FlavorName: "small"},
+		ProviderSpecificField: "whatever",
+	}
+	b, _ := opts.ToLoadBalancerCreateMap()
+
+	out, err := applyFlavorName(nil, opts, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lb := out["loadbalancer"].(map[string]interface{})
+	if lb["flavor"] != "resolved-flavor-id" {
+		t.Errorf("expected resolved flavor id to apply through the embedded CreateOpts, got %v", lb["flavor"])
+	}
+}
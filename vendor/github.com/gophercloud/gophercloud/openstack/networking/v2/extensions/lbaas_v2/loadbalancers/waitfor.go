@@ -0,0 +1,177 @@
+package loadbalancers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrTimeout is returned by WaitForStatus and WaitForDeletion when ctx is
+// cancelled or its deadline elapses before the target state is reached.
+var ErrTimeout = errors.New("timed out waiting for loadbalancer status")
+
+// ErrProvisioningFailed is returned when a provisioning_status in the
+// status tree transitions to ERROR while waiting for a different target
+// status.
+type ErrProvisioningFailed struct {
+	Status string
+}
+
+func (e ErrProvisioningFailed) Error() string {
+	return fmt.Sprintf("loadbalancer provisioning failed with status %q", e.Status)
+}
+
+// WaitOpts configures the polling behavior of WaitForStatus and
+// WaitForDeletion.
+type WaitOpts struct {
+	// InitialInterval is the delay before the first repoll. Defaults to 1
+	// second if zero.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between repolls. Defaults to 30 seconds if
+	// zero.
+	MaxInterval time.Duration
+	// BackoffFactor multiplies the interval after each repoll. Defaults to
+	// 2 if zero.
+	BackoffFactor float64
+	// Jitter randomizes each interval by +/- Jitter percent (0.0-1.0) to
+	// avoid many callers polling in lockstep. Ignored if zero.
+	Jitter float64
+	// IncludeChildren, if true, also waits for every child listener, pool,
+	// and member's provisioning_status to reach the target status alongside
+	// the loadbalancer's own status.
+	IncludeChildren bool
+}
+
+func (opts WaitOpts) withDefaults() WaitOpts {
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+	if opts.BackoffFactor <= 0 {
+		opts.BackoffFactor = 2
+	}
+	return opts
+}
+
+func (opts WaitOpts) nextInterval(current time.Duration) time.Duration {
+	next := time.Duration(float64(current) * opts.BackoffFactor)
+	if next > opts.MaxInterval {
+		next = opts.MaxInterval
+	}
+	return next
+}
+
+func (opts WaitOpts) jittered(interval time.Duration) time.Duration {
+	if opts.Jitter <= 0 {
+		return interval
+	}
+	delta := float64(interval) * opts.Jitter
+	offset := (rand.Float64()*2 - 1) * delta
+	return time.Duration(float64(interval) + offset)
+}
+
+func (opts WaitOpts) sleep(ctx context.Context, interval time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ErrTimeout
+	case <-time.After(opts.jittered(interval)):
+		return nil
+	}
+}
+
+// provisioningStatuses collects the loadbalancer's own provisioning_status
+// and, if includeChildren is set, every listener/pool/member status nested
+// beneath it in tree.
+func provisioningStatuses(tree *StatusTree, includeChildren bool) []string {
+	statuses := []string{tree.Loadbalancer.ProvisioningStatus}
+	if !includeChildren {
+		return statuses
+	}
+	for _, listener := range tree.Loadbalancer.Listeners {
+		statuses = append(statuses, listener.ProvisioningStatus)
+		for _, pool := range listener.Pools {
+			statuses = append(statuses, pool.ProvisioningStatus)
+			for _, member := range pool.Members {
+				statuses = append(statuses, member.ProvisioningStatus)
+			}
+		}
+	}
+	return statuses
+}
+
+// WaitForStatus polls id's status tree via GetStatuses, backing off between
+// polls per opts, until every status it considers (the loadbalancer's own,
+// plus children when opts.IncludeChildren is set) equals target, one of
+// them becomes ERROR, or ctx is cancelled or its deadline elapses.
+func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id string, target string, opts WaitOpts) error {
+	opts = opts.withDefaults()
+	interval := opts.InitialInterval
+
+	for {
+		tree, err := GetStatuses(c, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		reached := true
+		for _, status := range provisioningStatuses(tree, opts.IncludeChildren) {
+			if status == "ERROR" && target != "ERROR" {
+				return ErrProvisioningFailed{Status: status}
+			}
+			if status != target {
+				reached = false
+			}
+		}
+		if reached {
+			return nil
+		}
+
+		if err := opts.sleep(ctx, interval); err != nil {
+			return err
+		}
+		interval = opts.nextInterval(interval)
+	}
+}
+
+// WaitForDeletion polls id's status tree via GetStatuses, backing off
+// between polls per opts, until the loadbalancer is gone (a 404 is
+// returned), every status it considers becomes DELETED, one of them
+// becomes ERROR, or ctx is cancelled or its deadline elapses.
+func WaitForDeletion(ctx context.Context, c *gophercloud.ServiceClient, id string, opts WaitOpts) error {
+	opts = opts.withDefaults()
+	interval := opts.InitialInterval
+
+	for {
+		tree, err := GetStatuses(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return nil
+			}
+			return err
+		}
+
+		deleted := true
+		for _, status := range provisioningStatuses(tree, opts.IncludeChildren) {
+			if status == "ERROR" {
+				return ErrProvisioningFailed{Status: status}
+			}
+			if status != "DELETED" {
+				deleted = false
+			}
+		}
+		if deleted {
+			return nil
+		}
+
+		if err := opts.sleep(ctx, interval); err != nil {
+			return err
+		}
+		interval = opts.nextInterval(interval)
+	}
+}
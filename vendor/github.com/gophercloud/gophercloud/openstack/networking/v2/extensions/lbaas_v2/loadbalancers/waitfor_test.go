@@ -0,0 +1,71 @@
+package loadbalancers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaitOptsWithDefaults(t *testing.T) {
+	opts := WaitOpts{}.withDefaults()
+	if opts.InitialInterval != time.Second {
+		t.Errorf("expected default InitialInterval of 1s, got %s", opts.InitialInterval)
+	}
+	if opts.MaxInterval != 30*time.Second {
+		t.Errorf("expected default MaxInterval of 30s, got %s", opts.MaxInterval)
+	}
+	if opts.BackoffFactor != 2 {
+		t.Errorf("expected default BackoffFactor of 2, got %v", opts.BackoffFactor)
+	}
+}
+
+func TestWaitOptsNextInterval(t *testing.T) {
+	opts := WaitOpts{MaxInterval: 10 * time.Second, BackoffFactor: 2}
+	if got := opts.nextInterval(time.Second); got != 2*time.Second {
+		t.Errorf("expected 2s, got %s", got)
+	}
+	if got := opts.nextInterval(9 * time.Second); got != 10*time.Second {
+		t.Errorf("expected backoff to cap at MaxInterval (10s), got %s", got)
+	}
+}
+
+func TestProvisioningStatuses(t *testing.T) {
+	tree := &StatusTree{
+		Loadbalancer: LoadBalancer{
+			ProvisioningStatus: "ACTIVE",
+			Listeners: []Listener{
+				{
+					ProvisioningStatus: "ACTIVE",
+					Pools: []Pool{
+						{
+							ProvisioningStatus: "ACTIVE",
+							Members: []Member{
+								{ProvisioningStatus: "ACTIVE"},
+								{ProvisioningStatus: "ERROR"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	top := provisioningStatuses(tree, false)
+	if len(top) != 1 || top[0] != "ACTIVE" {
+		t.Errorf("expected only the loadbalancer status when IncludeChildren is false, got %v", top)
+	}
+
+	all := provisioningStatuses(tree, true)
+	if len(all) != 5 {
+		t.Fatalf("expected 5 statuses (lb, listener, pool, 2 members) got %d: %v", len(all), all)
+	}
+	if all[len(all)-1] != "ERROR" {
+		t.Errorf("expected the member's ERROR status to surface, got %v", all)
+	}
+}
+
+func TestErrProvisioningFailedError(t *testing.T) {
+	err := ErrProvisioningFailed{Status: "ERROR"}
+	if err.Error() == "" {
+		t.Error("expected a non-empty error message")
+	}
+}
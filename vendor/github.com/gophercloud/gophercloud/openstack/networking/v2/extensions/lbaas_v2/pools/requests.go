@@ -0,0 +1,63 @@
+package pools
+
+import "github.com/gophercloud/gophercloud"
+
+// BatchUpdateMembersOptsBuilder is the interface options structs have to
+// satisfy in order to be used in the BatchUpdateMembers operation in this
+// package.
+type BatchUpdateMembersOptsBuilder interface {
+	ToBatchMemberUpdateMap() (map[string]interface{}, error)
+}
+
+// BatchMemberOpts is the options struct used to describe a single member of
+// a pool's desired membership in a BatchUpdateMembers call.
+type BatchMemberOpts struct {
+	// Required. The IP address of the member.
+	Address string `json:"address" required:"true"`
+	// Required. The port on which the member listens for requests.
+	ProtocolPort int `json:"protocol_port" required:"true"`
+	// Optional. The relative weight of the member in the pool's load
+	// balancing algorithm.
+	Weight *int `json:"weight,omitempty"`
+	// Optional. The subnet the member's address belongs to.
+	SubnetID string `json:"subnet_id,omitempty"`
+	// Optional. The administrative state of the member. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+	// Optional. Human-readable name for the member.
+	Name string `json:"name,omitempty"`
+	// Optional. An alternate IP address used to health monitor the member.
+	MonitorAddress string `json:"monitor_address,omitempty"`
+	// Optional. An alternate port used to health monitor the member.
+	MonitorPort *int `json:"monitor_port,omitempty"`
+}
+
+// BatchUpdateMembersOpts is the common options struct used in this
+// package's BatchUpdateMembers operation.
+type BatchUpdateMembersOpts struct {
+	Members []BatchMemberOpts `json:"members" required:"true"`
+}
+
+// ToBatchMemberUpdateMap casts a BatchUpdateMembersOpts struct to a map.
+func (opts BatchUpdateMembersOpts) ToBatchMemberUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// BatchUpdateMembers replaces a pool's entire membership with the given set
+// of members in a single request. The control plane computes the adds,
+// updates, and deletes needed to reconcile the pool to this desired state.
+func BatchUpdateMembers(c *gophercloud.ServiceClient, poolID string, opts BatchUpdateMembersOptsBuilder) (r UpdateMembersResult) {
+	b, err := opts.ToBatchMemberUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(membersRootURL(c, poolID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
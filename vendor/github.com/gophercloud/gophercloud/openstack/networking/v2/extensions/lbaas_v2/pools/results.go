@@ -0,0 +1,10 @@
+package pools
+
+import "github.com/gophercloud/gophercloud"
+
+// UpdateMembersResult represents the result of a BatchUpdateMembers
+// operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type UpdateMembersResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,13 @@
+package pools
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "lbaas"
+	resourcePath = "pools"
+	memberPath   = "members"
+)
+
+func membersRootURL(c *gophercloud.ServiceClient, poolID string) string {
+	return c.ServiceURL(rootPath, resourcePath, poolID, memberPath)
+}